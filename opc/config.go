@@ -2,10 +2,12 @@ package opc
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-oracle-terraform/compute"
@@ -21,6 +23,7 @@ type Config struct {
 	IdentityDomain   string
 	Endpoint         string
 	MaxRetries       int
+	RequestTimeout   time.Duration
 	Insecure         bool
 	StorageEndpoint  string
 	StorageServiceId string
@@ -43,6 +46,10 @@ func (c *Config) Client() (*OPCClient, error) {
 		UserAgent:      &userAgentString,
 	}
 
+	if c.RequestTimeout > 0 {
+		config.RequestTimeout = &c.RequestTimeout
+	}
+
 	if logging.IsDebugOrHigher() {
 		config.LogLevel = opc.LogDebug
 		config.Logger = opcLogger{}
@@ -88,6 +95,13 @@ func (c *Config) Client() (*OPCClient, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Fail fast on bad credentials rather than on the first storage resource
+		if err := storageClient.Ping(); err != nil {
+			if errors.Is(err, storage.ErrUnauthorized) {
+				return nil, fmt.Errorf("The storage service at %s rejected the credentials, check `user`, `password` and `storage_service_id`: %s", c.StorageEndpoint, err)
+			}
+			return nil, fmt.Errorf("Error connecting to the storage service at %s: %s", c.StorageEndpoint, err)
+		}
 		opcClient.storageClient = storageClient
 	}
 
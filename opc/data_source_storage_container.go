@@ -0,0 +1,82 @@
+package opc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageContainer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageContainerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"read_acls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"write_acls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	name := d.Get("name").(string)
+
+	input := &storage.GetContainerInput{
+		Name: name,
+	}
+
+	container, err := storageClient.Containers().GetContainer(input)
+	if err != nil {
+		if client.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Storage Container %s: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("object_count", container.ObjectCount)
+	d.Set("bytes_used", container.BytesUsed)
+	if err := setStringList(d, "read_acls", container.ReadACLs); err != nil {
+		return err
+	}
+	if err := setStringList(d, "write_acls", container.WriteACLs); err != nil {
+		return err
+	}
+	return d.Set("metadata", container.CustomMetadata)
+}
@@ -0,0 +1,102 @@
+package opc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageContainerACL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageContainerACLRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"read": storageContainerACLComputedSchema(),
+
+			"write": storageContainerACLComputedSchema(),
+		},
+	}
+}
+
+func storageContainerACLComputedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"users": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"referrers": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"public": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"listings": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageContainerACLRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	name := d.Get("name").(string)
+
+	input := &storage.GetContainerInput{
+		Name: name,
+	}
+
+	container, err := storageClient.GetContainer(input)
+	if err != nil {
+		if client.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Storage Container ACLs %s: %s", name, err)
+	}
+
+	if container == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(name)
+	if err := d.Set("read", flattenStorageContainerACL(storage.ParseContainerACL(container.ReadACLs))); err != nil {
+		return err
+	}
+	if err := d.Set("write", flattenStorageContainerACL(storage.ParseContainerACL(container.WriteACLs))); err != nil {
+		return err
+	}
+	return nil
+}
+
+func flattenStorageContainerACL(acl *storage.ContainerACL) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"users":     acl.Users,
+			"referrers": acl.Referrers,
+			"public":    acl.Public,
+			"listings":  acl.Listings,
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package opc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPCStorageContainerACL_Basic(t *testing.T) {
+	dataSourceName := "data.opc_storage_container_acl.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOPCStorageContainerACLBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "read.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "read.0.public", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "read.0.listings", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "read.0.referrers.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "write.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "write.0.public", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOPCStorageContainerACLBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opc_storage_container" "test" {
+  name      = "acc-test-%d"
+  read_acls = [ ".r:*", ".rlistings" ]
+}
+
+data "opc_storage_container_acl" "test" {
+  name = "${opc_storage_container.test.name}"
+}
+`, rInt)
+}
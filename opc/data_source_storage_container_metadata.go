@@ -0,0 +1,57 @@
+package opc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageContainerMetadata() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageContainerMetadataRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStorageContainerMetadataRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	name := d.Get("name").(string)
+
+	input := &storage.GetContainerInput{
+		Name: name,
+	}
+
+	container, err := storageClient.GetContainer(input)
+	if err != nil {
+		if client.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Storage Container metadata %s: %s", name, err)
+	}
+
+	if container == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(name)
+	return d.Set("metadata", container.CustomMetadata)
+}
@@ -0,0 +1,46 @@
+package opc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOPCStorageContainerMetadata_Basic(t *testing.T) {
+	dataSourceName := "data.opc_storage_container_metadata.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOPCStorageContainerMetadataBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.Foo", "bar"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.Abc-Def", "xyz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOPCStorageContainerMetadataBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opc_storage_container" "test" {
+  name = "acc-test-%d"
+  metadata {
+    "Foo" = "bar",
+    "Abc-Def" = "xyz"
+  }
+}
+
+data "opc_storage_container_metadata" "test" {
+  name = "${opc_storage_container.test.name}"
+}
+`, rInt)
+}
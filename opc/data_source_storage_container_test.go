@@ -0,0 +1,48 @@
+package opc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestStorageContainerDataSourceRead(t *testing.T) {
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "HEAD" {
+			t.Errorf("Expected the container to be read with a HEAD, got %s", r.Method)
+		}
+		w.Header().Set("X-Container-Object-Count", "3")
+		w.Header().Set("X-Container-Bytes-Used", "2048")
+		w.Header().Set("X-Container-Read", ".rlistings,.r:*")
+		w.Header().Set("X-Container-Write", "test-domain.Storage.Storage_ReadWriteGroup")
+		w.Header().Set("X-Container-Meta-Owner", "ops")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceStorageContainer().Schema, map[string]interface{}{
+		"name": "test-container",
+	})
+	if err := dataSourceStorageContainerRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "test-container" {
+		t.Fatalf("Expected ID test-container, got %s", d.Id())
+	}
+	if v := d.Get("object_count").(int); v != 3 {
+		t.Fatalf("Expected 3 objects, got %d", v)
+	}
+	if v := d.Get("bytes_used").(int); v != 2048 {
+		t.Fatalf("Expected 2048 bytes used, got %d", v)
+	}
+	if v := d.Get("read_acls").([]interface{}); len(v) != 2 || v[0] != ".r:*" || v[1] != ".rlistings" {
+		t.Fatalf("Expected the sorted read ACLs, got %v", v)
+	}
+	if v := d.Get("write_acls.0").(string); v != "test-domain.Storage.Storage_ReadWriteGroup" {
+		t.Fatalf("Expected the write ACL, got %q", v)
+	}
+	if v := d.Get("metadata.Owner").(string); v != "ops" {
+		t.Fatalf("Expected metadata Owner ops, got %q", v)
+	}
+}
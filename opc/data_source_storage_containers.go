@@ -0,0 +1,94 @@
+package opc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageContainers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageContainersRead,
+
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"containers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStorageContainersRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	prefix := d.Get("prefix").(string)
+
+	// Page through the listing until the account is fully enumerated
+	var result []storage.ContainerInfo
+	input := &storage.ListContainersInput{
+		Prefix: prefix,
+	}
+	for {
+		containers, err := storageClient.ListContainers(input)
+		if err != nil {
+			return fmt.Errorf("Error listing Storage Containers: %s", err)
+		}
+		if len(containers) == 0 || containers[len(containers)-1].Name == input.Marker {
+			break
+		}
+		result = append(result, containers...)
+		input.Marker = containers[len(containers)-1].Name
+	}
+
+	containers := make([]interface{}, 0, len(result))
+	names := make([]string, 0, len(result))
+	for _, container := range result {
+		containers = append(containers, map[string]interface{}{
+			"name":         container.Name,
+			"object_count": container.ObjectCount,
+			"bytes_used":   container.BytesUsed,
+		})
+		names = append(names, container.Name)
+	}
+
+	d.SetId(fmt.Sprintf("storage-containers-%s", prefix))
+	if err := d.Set("containers", containers); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package opc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/go-oracle-terraform/opc"
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccOPCStorageContainers_Basic(t *testing.T) {
+	dataSourceName := "data.opc_storage_containers.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOPCStorageContainersBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "containers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "containers.0.name", fmt.Sprintf("acc-test-%d-a", rInt)),
+					resource.TestCheckResourceAttr(dataSourceName, "containers.0.object_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestStorageContainersRead_pagination(t *testing.T) {
+	pages := map[string][]storage.ContainerInfo{
+		"":            {{Name: "container-1", ObjectCount: 1, BytesUsed: 10}, {Name: "container-2", ObjectCount: 2, BytesUsed: 20}},
+		"container-2": {{Name: "container-3", ObjectCount: 3, BytesUsed: 30}},
+		"container-3": {},
+	}
+
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("prefix") != "container-" {
+			t.Errorf("Expected prefix container-, got %q", r.URL.Query().Get("prefix"))
+		}
+		json.NewEncoder(w).Encode(pages[r.URL.Query().Get("marker")])
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceStorageContainers().Schema, map[string]interface{}{
+		"prefix": "container-",
+	})
+	if err := dataSourceStorageContainersRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := d.Get("containers.#").(int); count != 3 {
+		t.Fatalf("Expected 3 containers across all pages, got %d", count)
+	}
+	if name := d.Get("names.2").(string); name != "container-3" {
+		t.Fatalf("Expected the last name to be container-3, got %s", name)
+	}
+	if bytes := d.Get("containers.1.bytes_used").(int); bytes != 20 {
+		t.Fatalf("Expected container-2 to use 20 bytes, got %d", bytes)
+	}
+}
+
+// Returns provider meta whose storage client talks to a test server running the handler
+func testStorageMeta(t *testing.T, handler http.HandlerFunc) *OPCClient {
+	return testStorageMetaConfig(t, handler, func(*opc.Config) {})
+}
+
+// Returns provider meta like testStorageMeta, with the client's config adjusted first
+func testStorageMetaConfig(t *testing.T, handler http.HandlerFunc, configure func(*opc.Config)) *OPCClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/v1.0" {
+			w.Header().Set("X-Auth-Token", "test-token")
+			return
+		}
+		if r.URL.Path == "/info" {
+			// No optional features, such as legal holds, are published
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	endpoint, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &opc.Config{
+		IdentityDomain: opc.String("test-domain"),
+		Username:       opc.String("test-user"),
+		Password:       opc.String("test-password"),
+		APIEndpoint:    endpoint,
+		HTTPClient:     &http.Client{},
+	}
+	configure(config)
+	storageClient, err := storage.NewStorageClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &OPCClient{storageClient: storageClient}
+}
+
+func testAccOPCStorageContainersBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opc_storage_container" "a" {
+  name = "acc-test-%d-a"
+}
+
+resource "opc_storage_container" "b" {
+  name = "acc-test-%d-b"
+}
+
+data "opc_storage_containers" "test" {
+  prefix     = "acc-test-%d-"
+  depends_on = ["opc_storage_container.a", "opc_storage_container.b"]
+}
+`, rInt, rInt, rInt)
+}
@@ -0,0 +1,103 @@
+package opc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-oracle-terraform/client"
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"uploaded_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStorageObjectRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	container := d.Get("container").(string)
+	name := d.Get("name").(string)
+
+	// Read the object's details with a HEAD, so its content isn't downloaded
+	input := &storage.GetObjectInput{
+		Container: container,
+		Name:      name,
+	}
+
+	object, err := storageClient.Objects().GetObjectMetadata(input)
+	if err != nil {
+		if client.WasNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Storage Object %s/%s: %s", container, name, err)
+	}
+
+	if object == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(object.ID)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("content_length", object.ContentLength)
+	d.Set("content_type", object.ContentType)
+	// Swift may quote the ETag; expose the bare MD5
+	d.Set("etag", strings.Trim(object.Etag, "\""))
+	d.Set("last_modified", object.LastModified)
+	d.Set("uploaded_by", object.UploadedBy)
+	return d.Set("metadata", object.ObjectMetadata)
+}
@@ -0,0 +1,58 @@
+package opc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestStorageObjectDataSourceRead(t *testing.T) {
+	var methods []string
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("ETag", `"5d41402abc4b2a76b9719d911017c592"`)
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Content-Length", "1024")
+		w.Header().Set("Last-Modified", "Tue, 01 Sep 2020 10:00:00 GMT")
+		w.Header().Set("X-Object-Meta-Build", "42")
+		w.Header().Set("Content-Disposition", `attachment; filename="app.png"`)
+		w.Header().Set("X-Object-Sysmeta-Uploaded-By", "Storage-test-domain:jane.doe@example.com")
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceStorageObject().Schema, map[string]interface{}{
+		"container": "images",
+		"name":      "builds/app.png",
+	})
+	if err := dataSourceStorageObjectRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(methods) != 1 || methods[0] != "HEAD" {
+		t.Fatalf("Expected the object to be read with a single HEAD, got %v", methods)
+	}
+	if d.Id() != "images/builds/app.png" {
+		t.Fatalf("Expected ID images/builds/app.png, got %s", d.Id())
+	}
+	if v := d.Get("content_length").(int); v != 1024 {
+		t.Fatalf("Expected content_length 1024, got %d", v)
+	}
+	if v := d.Get("content_type").(string); v != "image/png" {
+		t.Fatalf("Expected content_type image/png, got %s", v)
+	}
+	if v := d.Get("etag").(string); v != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("Expected the unquoted etag, got %s", v)
+	}
+	if v := d.Get("last_modified").(string); v != "Tue, 01 Sep 2020 10:00:00 GMT" {
+		t.Fatalf("Expected last_modified to be set, got %s", v)
+	}
+	if v := d.Get("content_disposition").(string); v != `attachment; filename="app.png"` {
+		t.Fatalf("Expected the content disposition to be read, got %s", v)
+	}
+	if v := d.Get("uploaded_by").(string); v != "Storage-test-domain:jane.doe@example.com" {
+		t.Fatalf("Expected the uploader to be read, got %q", v)
+	}
+	if v := d.Get("metadata.Build").(string); v != "42" {
+		t.Fatalf("Expected metadata Build 42, got %q", v)
+	}
+}
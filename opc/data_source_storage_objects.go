@@ -0,0 +1,99 @@
+package opc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceStorageObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStorageObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStorageObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*OPCClient).storageClient
+	if storageClient == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	container := d.Get("container").(string)
+	prefix := d.Get("prefix").(string)
+
+	input := &storage.ListObjectsInput{
+		Container: container,
+		Prefix:    prefix,
+		Delimiter: d.Get("delimiter").(string),
+	}
+	result, err := storageClient.Objects().ListAllObjects(input)
+	if err != nil {
+		return fmt.Errorf("Error listing Storage Objects in %s: %s", container, err)
+	}
+
+	objects := make([]interface{}, 0, len(result))
+	names := make([]string, 0, len(result))
+	for _, object := range result {
+		objects = append(objects, map[string]interface{}{
+			"name": object.Name,
+			"size": int(object.ContentLength),
+			"etag": strings.Trim(object.Etag, "\""),
+		})
+		names = append(names, object.Name)
+	}
+
+	d.SetId(fmt.Sprintf("storage-objects-%s/%s", container, prefix))
+	if err := d.Set("objects", objects); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+	return nil
+}
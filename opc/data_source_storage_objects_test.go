@@ -0,0 +1,49 @@
+package opc
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestStorageObjectsRead(t *testing.T) {
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("prefix") != "logs/" || query.Get("delimiter") != "/" {
+			t.Errorf("Expected prefix logs/ and delimiter /, got %v", query)
+		}
+		if query.Get("marker") != "" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"name": "logs/a.log", "bytes": 10, "hash": "0cc175b9c0f1b6a831c399e269772661"},
+			{"name": "logs/b.log", "bytes": 20, "hash": "92eb5ffee6ae2fec3ad71c777531578f"},
+			{"subdir": "logs/archive/"}
+		]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceStorageObjects().Schema, map[string]interface{}{
+		"container": "test-container",
+		"prefix":    "logs/",
+		"delimiter": "/",
+	})
+	if err := dataSourceStorageObjectsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := d.Get("objects.#").(int); count != 3 {
+		t.Fatalf("Expected 3 objects, got %d", count)
+	}
+	if size := d.Get("objects.1.size").(int); size != 20 {
+		t.Fatalf("Expected logs/b.log to be 20 bytes, got %d", size)
+	}
+	if etag := d.Get("objects.0.etag").(string); etag != "0cc175b9c0f1b6a831c399e269772661" {
+		t.Fatalf("Expected the etag of logs/a.log, got %s", etag)
+	}
+	if name := d.Get("names.2").(string); name != "logs/archive/" {
+		t.Fatalf("Expected the rolled up subdirectory logs/archive/, got %s", name)
+	}
+}
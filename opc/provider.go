@@ -1,6 +1,9 @@
 package opc
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -45,6 +48,19 @@ func Provider() terraform.ResourceProvider {
 				Description: "Maximum number retries to wait for a successful response when operating on resources within OPC (defaults to 1)",
 			},
 
+			"request_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPC_REQUEST_TIMEOUT", ""),
+				Description: "Deadline, such as 2m, for each request including its retries. Uploads of storage object files are bound by the resource's timeouts instead. Unset leaves requests without a deadline.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := time.ParseDuration(v.(string)); v.(string) != "" && err != nil {
+						errors = append(errors, fmt.Errorf("%q must be a duration, such as 2m: %s", k, err))
+					}
+					return
+				},
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -73,6 +89,12 @@ func Provider() terraform.ResourceProvider {
 			"opc_compute_network_interface":       dataSourceNetworkInterface(),
 			"opc_compute_storage_volume_snapshot": dataSourceStorageVolumeSnapshot(),
 			"opc_compute_vnic":                    dataSourceVNIC(),
+			"opc_storage_container":               dataSourceStorageContainer(),
+			"opc_storage_container_acl":           dataSourceStorageContainerACL(),
+			"opc_storage_container_metadata":      dataSourceStorageContainerMetadata(),
+			"opc_storage_containers":              dataSourceStorageContainers(),
+			"opc_storage_object":                  dataSourceStorageObject(),
+			"opc_storage_objects":                 dataSourceStorageObjects(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -112,12 +134,18 @@ func Provider() terraform.ResourceProvider {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	var requestTimeout time.Duration
+	if v := d.Get("request_timeout").(string); v != "" {
+		requestTimeout, _ = time.ParseDuration(v)
+	}
+
 	config := Config{
 		User:             d.Get("user").(string),
 		Password:         d.Get("password").(string),
 		IdentityDomain:   d.Get("identity_domain").(string),
 		Endpoint:         d.Get("endpoint").(string),
 		MaxRetries:       d.Get("max_retries").(int),
+		RequestTimeout:   requestTimeout,
 		Insecure:         d.Get("insecure").(bool),
 		StorageEndpoint:  d.Get("storage_endpoint").(string),
 		StorageServiceId: d.Get("storage_service_id").(string),
@@ -1,7 +1,11 @@
 package opc
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/go-oracle-terraform/client"
 	"github.com/hashicorp/go-oracle-terraform/storage"
@@ -22,6 +26,7 @@ func resourceOPCStorageContainer() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"read_acls": {
 				Type:     schema.TypeList,
@@ -71,6 +76,12 @@ func resourceOPCStorageContainer() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"storage_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			// "georeplication_policy": {
 			// 	Type:     schema.TypeList,
 			// 	Optional: true,
@@ -112,10 +123,13 @@ func resourceOPCStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		input.MaxAge = maxAge.(int)
 	}
 	if quotaBytes, ok := d.GetOk("quota_bytes"); ok {
-		input.QuotaBytes = quotaBytes.(int)
+		input.QuotaBytes = int64(quotaBytes.(int))
 	}
 	if quotaCount, ok := d.GetOk("quota_count"); ok {
-		input.QuotaCount = quotaCount.(int)
+		input.QuotaCount = int64(quotaCount.(int))
+	}
+	if storagePolicy, ok := d.GetOk("storage_policy"); ok {
+		input.StoragePolicy = storagePolicy.(string)
 	}
 
 	if v, ok := d.GetOk("metadata"); ok {
@@ -168,6 +182,13 @@ func resourceOPCStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("max_age", result.MaxAge)
 	d.Set("quota_bytes", result.QuotaBytes)
 	d.Set("quota_count", result.QuotaCount)
+	d.Set("storage_policy", result.StoragePolicy)
+
+	// Flag metadata that was added to the container outside of Terraform
+	if added := unmanagedMetadata(d.Get("metadata").(map[string]interface{}), result.CustomMetadata); len(added) > 0 {
+		log.Printf("[WARN] Storage Container '%s' has metadata that is not managed by Terraform: %s",
+			name, strings.Join(added, ", "))
+	}
 	d.Set("metadata", result.CustomMetadata)
 
 	if err := setStringList(d, "read_acls", result.ReadACLs); err != nil {
@@ -196,7 +217,12 @@ func resourceOPCStorageContainerDelete(d *schema.ResourceData, meta interface{})
 	input := storage.DeleteContainerInput{
 		Name: name,
 	}
-	if err := client.DeleteContainer(&input); err != nil {
+	if err := client.Containers().DeleteContainer(&input); err != nil {
+		if errors.Is(err, storage.ErrContainerNotEmpty) {
+			return fmt.Errorf("Error deleting Storage Container '%s': the container still holds objects. "+
+				"Delete them, or remove the container from the configuration without destroying it "+
+				"with `terraform state rm`, before trying again", name)
+		}
 		return fmt.Errorf("Error deleting Storage Container '%s': %s", name, err)
 	}
 
@@ -234,10 +260,10 @@ func resourceOPCStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 		input.MaxAge = maxAge.(int)
 	}
 	if quotaBytes, ok := d.GetOk("quota_bytes"); ok {
-		input.QuotaBytes = quotaBytes.(int)
+		input.QuotaBytes = int64(quotaBytes.(int))
 	}
 	if quotaCount, ok := d.GetOk("quota_count"); ok {
-		input.QuotaCount = quotaCount.(int)
+		input.QuotaCount = int64(quotaCount.(int))
 	}
 
 	// Create list of metadata headers to be removed
@@ -289,3 +315,15 @@ func contains(s []string, e string) bool {
 	}
 	return false
 }
+
+// list the keys of the actual metadata of a resource that aren't in the desired metadata
+func unmanagedMetadata(desired map[string]interface{}, actual map[string]string) []string {
+	var added []string
+	for k := range actual {
+		if _, ok := desired[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	sort.Strings(added)
+	return added
+}
@@ -2,11 +2,16 @@ package opc
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -82,6 +87,129 @@ func TestAccOPCStorageContainer_Updated(t *testing.T) {
 	})
 }
 
+func TestStorageContainerDelete_notEmpty(t *testing.T) {
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected only a DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	d := resourceOPCStorageContainer().Data(&terraform.InstanceState{ID: "test-container"})
+	err := resourceOPCStorageContainerDelete(d, meta)
+	if err == nil || !strings.Contains(err.Error(), "still holds objects") {
+		t.Fatalf("Expected an error saying the container isn't empty, got %v", err)
+	}
+}
+
+func TestStorageContainer_storagePolicy(t *testing.T) {
+	var created http.Header
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			created = r.Header
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.Header().Set("X-Storage-Policy", created.Get("X-Storage-Policy"))
+			w.Header().Set("X-Container-Meta-Quota-Bytes", "1024")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	r := resourceOPCStorageContainer()
+	raw := map[string]interface{}{
+		"name":           "test-container",
+		"storage_policy": "gold",
+		"quota_bytes":    1024,
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if err := resourceOPCStorageContainerCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if created.Get("X-Storage-Policy") != "gold" {
+		t.Fatalf("Expected the container to be created with the gold policy, got %v", created)
+	}
+	if v := d.Get("storage_policy").(string); v != "gold" {
+		t.Fatalf("Expected storage_policy gold to be read back, got %q", v)
+	}
+
+	raw["storage_policy"] = "silver"
+	raw["quota_bytes"] = 2048
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["storage_policy"]; attr == nil || !attr.RequiresNew {
+		t.Fatalf("Expected a changed storage policy to replace the container, got %#v", attr)
+	}
+	if attr := diff.Attributes["quota_bytes"]; attr == nil || attr.RequiresNew {
+		t.Fatalf("Expected a changed quota to be updated in place, got %#v", attr)
+	}
+}
+
+func TestStorageContainerImport(t *testing.T) {
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Container-Read", ".r:*,.rlistings")
+		w.Header().Set("X-Container-Meta-Temp-Url-Key", "secret")
+		w.Header().Set("X-Container-Meta-Quota-Bytes", "1024")
+		w.Header().Set("X-Container-Meta-Owner", "ops")
+		w.Header().Set("X-Storage-Policy", "gold")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resourceOPCStorageContainer()
+	imported, err := r.Importer.State(r.Data(&terraform.InstanceState{ID: "test-container"}), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := imported[0]
+	if err := resourceOPCStorageContainerRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":           "test-container",
+		"read_acls":      []interface{}{".r:*", ".rlistings"},
+		"primary_key":    "secret",
+		"quota_bytes":    1024,
+		"metadata":       map[string]interface{}{"Owner": "ops"},
+		"storage_policy": "gold",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no diff after importing the container, got %#v", diff.Attributes)
+	}
+}
+
+func TestUnmanagedMetadata(t *testing.T) {
+	desired := map[string]interface{}{
+		"Foo":     "bar",
+		"Abc-Def": "xyz",
+		"Removed": "value",
+	}
+	actual := map[string]string{
+		"Foo":      "bar",
+		"Abc-Def":  "changed",
+		"External": "added",
+	}
+
+	// Only keys added outside of Terraform are reported
+	expected := []string{"External"}
+	if added := unmanagedMetadata(desired, actual); !reflect.DeepEqual(added, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, added)
+	}
+}
+
 func testAccCheckStorageContainerExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*OPCClient).storageClient
 
@@ -2,10 +2,20 @@ package opc
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-oracle-terraform/client"
 	"github.com/hashicorp/go-oracle-terraform/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mitchellh/go-homedir"
@@ -15,9 +25,17 @@ func resourceOPCStorageObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOPCStorageObjectCreate,
 		Read:   resourceOPCStorageObjectRead,
+		Update: resourceOPCStorageObjectUpdate,
 		Delete: resourceOPCStorageObjectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceOPCStorageObjectImport,
+		},
+
+		// Uploading a large file can legitimately take a long time
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,28 +55,51 @@ func resourceOPCStorageObject() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				Description:   "Raw content in string-form of the data",
-				ConflictsWith: []string{"copy_from", "file"},
+				Description:   "Raw content in string-form of the data. Its type is detected unless `content_type` is set",
+				ConflictsWith: []string{"copy_from", "file", "source"},
 			},
 			"file": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				Description:   "File path for the content to use for data",
-				ConflictsWith: []string{"copy_from", "content"},
+				ConflictsWith: []string{"copy_from", "content", "source"},
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Path of a local file to upload. The object is replaced when the file's content changes",
+				ConflictsWith: []string{"copy_from", "content", "file"},
+				// Store the MD5 of the file rather than its path, so a change to its content is a diff
+				StateFunc: storageObjectSourceState,
 			},
 			"content_disposition": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
-				Description: "Overrides the behavior of the browser",
+				Description: "Overrides the behavior of the browser, e.g. attachment; filename=\"report.csv\" to download the object",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, _, err := mime.ParseMediaType(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf(
+							"%q must be a disposition type with optional parameters, e.g. attachment; filename=\"report.csv\": %s", k, err))
+					}
+					return
+				},
 			},
 			"content_encoding": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Set the content-encoding metadata",
 			},
+			"gzip": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"copy_from"},
+				Description:   "Compress the content with gzip before uploading it, setting the content-encoding to gzip",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -70,7 +111,7 @@ func resourceOPCStorageObject() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"content", "file"},
+				ConflictsWith: []string{"content", "file", "source"},
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
 					if !strings.Contains(value, "/") {
@@ -82,11 +123,25 @@ func resourceOPCStorageObject() *schema.Resource {
 				},
 			},
 			"delete_at": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Description: "The date and time in UNIX Epoch time stamp format when the system removes the object",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"delete_after"},
+				Description:      "The RFC3339 date and time when the system removes the object. A UNIX Epoch time stamp is also accepted",
+				ValidateFunc:     validateStorageObjectDeleteAt,
+				DiffSuppressFunc: suppressStorageObjectDeleteAtDifferences,
+			},
+			"delete_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"delete_at"},
+				Description:   "The duration, such as 720h, after which the system removes the object",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if duration, err := time.ParseDuration(v.(string)); err != nil || duration < time.Second {
+						errors = append(errors, fmt.Errorf("%q must be a duration of at least 1s, such as 720h", k))
+					}
+					return
+				},
 			},
 			"etag": {
 				Type:        schema.TypeString,
@@ -99,8 +154,7 @@ func resourceOPCStorageObject() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
-				Description: "The object metadata",
+				Description: "The object metadata. Updated in place without uploading the content again",
 			},
 			"transfer_encoding": {
 				Type:        schema.TypeString,
@@ -123,6 +177,11 @@ func resourceOPCStorageObject() *schema.Resource {
 				Computed:    true,
 				Description: "Type of ranges that the object accepts",
 			},
+			"content_md5": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MD5 checksum of the `source` file when it was uploaded",
+			},
 			"content_length": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -148,6 +207,11 @@ func resourceOPCStorageObject() *schema.Resource {
 				Computed:    true,
 				Description: "Transaction ID of the request. Used for bug reports",
 			},
+			"uploaded_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User that uploaded the object, if recorded by the service",
+			},
 		},
 	}
 }
@@ -158,17 +222,25 @@ func resourceOPCStorageObjectCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf(StorageClientInitError)
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	// Populate required attr
 	input := &storage.CreateObjectInput{
 		Name:      d.Get("name").(string),
 		Container: d.Get("container").(string),
 	}
 
-	// Check for `content` or `file`.
+	// Check for `content`, `file` or `source`.
 	if v, ok := d.GetOk("content"); ok {
 		// Read content as io.ReadSeeker
 		content := v.(string)
 		input.Body = bytes.NewReader([]byte(content))
+		checksum := md5.Sum([]byte(content))
+		input.ETag = hex.EncodeToString(checksum[:])
+		if _, ok := d.GetOk("content_type"); !ok {
+			input.ContentType = storageObjectContentType(input.Name, content)
+		}
 	} else if v, ok := d.GetOk("file"); ok {
 		// Read raw file
 		source := v.(string)
@@ -181,11 +253,32 @@ func resourceOPCStorageObjectCreate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error opening Storage Object file (%s): %s", source, err)
 		}
 		input.Body = file
+		ctx = storageObjectUploadContext(ctx)
+	} else if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		checksum, err := storageObjectSourceMD5(source)
+		if err != nil {
+			return err
+		}
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("Error opening Storage Object source (%s): %s", source, err)
+		}
+		defer file.Close()
+		input.Body = file
+		ctx = storageObjectUploadContext(ctx)
+		// Have the service reject the upload if the file changes while it's sent
+		input.ETag = checksum
+		d.Set("content_md5", checksum)
 	} else if v, ok := d.GetOk("copy_from"); ok {
 		input.CopyFrom = v.(string)
 	} else {
-		// One of the three attributes are required
-		return fmt.Errorf("Must specify `file`, `copy_from`, or `content` field")
+		// One of the four attributes are required
+		return fmt.Errorf("Must specify `file`, `source`, `copy_from`, or `content` field")
 	}
 
 	if v, ok := d.GetOk("content_disposition"); ok {
@@ -196,15 +289,38 @@ func resourceOPCStorageObjectCreate(d *schema.ResourceData, meta interface{}) er
 		input.ContentEncoding = v.(string)
 	}
 
+	if d.Get("gzip").(bool) {
+		if input.ContentEncoding != "" && input.ContentEncoding != "gzip" {
+			return fmt.Errorf("`content_encoding` (%s) must be gzip or unset when `gzip` is set", input.ContentEncoding)
+		}
+		input.Compress = true
+		// The object's ETag is the MD5 of what's stored, the compressed content,
+		// which the client computes once it has compressed the body
+		input.ETag = ""
+		input.VerifyChecksum = true
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		input.ContentType = v.(string)
 	}
 
 	if v, ok := d.GetOk("delete_at"); ok {
-		input.DeleteAt = v.(int)
+		deleteAt, err := parseStorageObjectDeleteAt(v.(string))
+		if err != nil {
+			return err
+		}
+		input.DeleteAt = deleteAt.Unix()
+	}
+
+	if v, ok := d.GetOk("delete_after"); ok {
+		duration, _ := time.ParseDuration(v.(string))
+		input.DeleteAfter = int(duration.Seconds())
 	}
 
 	if v, ok := d.GetOk("etag"); ok {
+		if input.ETag != "" && input.ETag != v.(string) {
+			return fmt.Errorf("`etag` (%s) does not match the MD5 of the object's content (%s)", v.(string), input.ETag)
+		}
 		input.ETag = v.(string)
 	}
 
@@ -220,7 +336,7 @@ func resourceOPCStorageObjectCreate(d *schema.ResourceData, meta interface{}) er
 		input.TransferEncoding = v.(string)
 	}
 
-	result, err := client.CreateObject(input)
+	result, err := client.CreateObjectWithContext(ctx, input)
 	if err != nil {
 		return fmt.Errorf("Error creating Object: %s", err)
 	}
@@ -230,6 +346,14 @@ func resourceOPCStorageObjectCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceOPCStorageObjectRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+	return readStorageObject(ctx, d, meta)
+}
+
+// Reads the object's details into the resource, bound by the context. Import reads
+// with it directly, as the resource's timeouts aren't set when importing.
+func readStorageObject(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*OPCClient).storageClient.Objects()
 	if client == nil {
 		return fmt.Errorf(StorageClientInitError)
@@ -239,7 +363,7 @@ func resourceOPCStorageObjectRead(d *schema.ResourceData, meta interface{}) erro
 		ID: d.Id(),
 	}
 
-	result, err := client.GetObject(input)
+	result, err := client.GetObjectMetadataWithContext(ctx, input)
 	if err != nil {
 		return fmt.Errorf("Error reading Storage Container Object (%s): %s", d.Id(), err)
 	}
@@ -256,27 +380,189 @@ func resourceOPCStorageObjectRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("content_length", result.ContentLength)
 	d.Set("content_type", result.ContentType)
 	d.Set("date", result.Date)
-	d.Set("etag", result.Etag)
+	// Swift may quote the ETag; store the bare MD5 so it matches a configured etag
+	d.Set("etag", strings.Trim(result.Etag, "\""))
 	d.Set("last_modified", result.LastModified)
-	d.Set("delete_at", result.DeleteAt)
+	if result.DeleteAt != 0 {
+		d.Set("delete_at", time.Unix(result.DeleteAt, 0).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("delete_at", "")
+	}
 	d.Set("object_manifest", result.ObjectManifest)
-	d.Set("metadata", result.ObjectMetadata)
+	// A legal hold is placed and released outside of the configuration, and is kept
+	// when the metadata is updated, so it isn't reported as a change
+	metadata := make(map[string]string)
+	for key, value := range result.ObjectMetadata {
+		if key != storage.LegalHoldMetadata {
+			metadata[key] = value
+		}
+	}
+	d.Set("metadata", metadata)
 	d.Set("timestamp", result.Timestamp)
 	d.Set("transaction_id", result.TransactionID)
+	d.Set("uploaded_by", result.UploadedBy)
 
 	return nil
 }
 
+// Imports the object with the `container/name` ID. Its content can't be read back into
+// `content` or `file`, but `source` is set to the object's MD5 so a configured source
+// file with the same content adopts the object rather than replacing it.
+func resourceOPCStorageObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid Storage Object ID (%s), expected container/name", d.Id())
+	}
+
+	if err := readStorageObject(context.Background(), d, meta); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Storage Object %s/%s not found", parts[0], parts[1])
+	}
+
+	// The ETag of a dynamic large object isn't the MD5 of its content
+	if d.Get("object_manifest").(string) == "" {
+		d.Set("source", d.Get("etag"))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceOPCStorageObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*OPCClient).storageClient.Objects()
+	if client == nil {
+		return fmt.Errorf(StorageClientInitError)
+	}
+
+	// The update replaces all of the object's metadata, so send what's kept as well as
+	// what's changed
+	input := &storage.UpdateObjectMetadataInput{
+		Name:               d.Get("name").(string),
+		Container:          d.Get("container").(string),
+		ContentDisposition: d.Get("content_disposition").(string),
+		ContentEncoding:    d.Get("content_encoding").(string),
+	}
+
+	// A changed delete_after restarts the countdown from now. Otherwise the current
+	// expiry, which delete_after was turned into when it was set, is kept.
+	if v := d.Get("delete_after").(string); v != "" && d.HasChange("delete_after") {
+		duration, _ := time.ParseDuration(v)
+		input.DeleteAfter = int(duration.Seconds())
+	} else if v := d.Get("delete_at").(string); v != "" {
+		deleteAt, err := parseStorageObjectDeleteAt(v)
+		if err != nil {
+			return err
+		}
+		input.DeleteAt = deleteAt.Unix()
+	}
+
+	metadata := make(map[string]string)
+	for name, value := range d.Get("metadata").(map[string]interface{}) {
+		metadata[name] = value.(string)
+	}
+	input.ObjectMetadata = metadata
+
+	if _, err := client.UpdateObjectMetadata(input); err != nil {
+		return fmt.Errorf("Error updating Storage Object metadata (%s): %s", d.Id(), err)
+	}
+
+	return resourceOPCStorageObjectRead(d, meta)
+}
+
+// Marks the upload of a file as a large object transfer, so it's bound by the create
+// timeout rather than the provider's request_timeout
+func storageObjectUploadContext(ctx context.Context) context.Context {
+	return client.WithLargeObjectTransfer(ctx)
+}
+
+// Parses the `delete_at` expiry, either an RFC3339 date and time or a UNIX Epoch time stamp
+func parseStorageObjectDeleteAt(v string) (time.Time, error) {
+	if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(epoch, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("`delete_at` (%s) must be an RFC3339 date and time or a UNIX Epoch time stamp", v)
+	}
+	return t, nil
+}
+
+func validateStorageObjectDeleteAt(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseStorageObjectDeleteAt(v.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// Suppress the diff of `delete_at` values that are the same time in different formats
+func suppressStorageObjectDeleteAtDifferences(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := parseStorageObjectDeleteAt(old)
+	if err != nil {
+		return false
+	}
+	newTime, err := parseStorageObjectDeleteAt(new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// Returns the MIME type of inline `content`, from the extension of the object's name
+// if it has a known one, otherwise by sniffing the content itself
+func storageObjectContentType(name, content string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType([]byte(content))
+}
+
+// Returns the MD5 checksum of the content of the `source` file
+func storageObjectSourceMD5(source string) (string, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return "", fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("Error opening Storage Object source (%s): %s", source, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("Error reading Storage Object source (%s): %s", source, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Stores `source` as the MD5 of the file's content. A file that can't be read is stored
+// as its path, so the error is reported when the object is created.
+func storageObjectSourceState(v interface{}) string {
+	source := v.(string)
+	checksum, err := storageObjectSourceMD5(source)
+	if err != nil {
+		return source
+	}
+	return checksum
+}
+
 func resourceOPCStorageObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*OPCClient).storageClient.Objects()
 	if client == nil {
 		return fmt.Errorf(StorageClientInitError)
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	// A static large object, as content too large for a single upload is stored, is
+	// deleted along with its segments. The segments of a dynamic large object are
+	// objects of their own, so are left alone.
 	input := &storage.DeleteObjectInput{
-		ID: d.Id(),
+		ID:             d.Id(),
+		DeleteSegments: d.Get("object_manifest").(string) == "",
 	}
-	if err := client.DeleteObject(input); err != nil {
+	if err := client.DeleteObjectWithContext(ctx, input); err != nil {
 		return fmt.Errorf("Error deleting Storage Container Object (%s): %s", d.Id(), err)
 	}
 
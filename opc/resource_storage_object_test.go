@@ -1,12 +1,24 @@
 package opc
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-oracle-terraform/opc"
 	"github.com/hashicorp/go-oracle-terraform/storage"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -31,7 +43,7 @@ func TestAccOPCStorageObject_contentSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "container", fmt.Sprintf("acc-test-%d", rInt)),
 					resource.TestCheckResourceAttrSet(resName, "content_length"),
 					resource.TestCheckResourceAttr(resName, "content_type", "text/plain;charset=UTF-8"),
-					resource.TestCheckResourceAttr(resName, "delete_at", "0"),
+					resource.TestCheckResourceAttr(resName, "delete_at", ""),
 					resource.TestCheckResourceAttrSet(resName, "last_modified"),
 					resource.TestCheckResourceAttrSet(resName, "timestamp"),
 					resource.TestCheckResourceAttrSet(resName, "transaction_id"),
@@ -60,7 +72,7 @@ func TestAccOPCStorageObject_contentSource_nilContentType(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "container", fmt.Sprintf("acc-test-%d", rInt)),
 					resource.TestCheckResourceAttrSet(resName, "content_length"),
 					resource.TestCheckResourceAttrSet(resName, "content_type"),
-					resource.TestCheckResourceAttr(resName, "delete_at", "0"),
+					resource.TestCheckResourceAttr(resName, "delete_at", ""),
 					resource.TestCheckResourceAttrSet(resName, "last_modified"),
 					resource.TestCheckResourceAttrSet(resName, "timestamp"),
 					resource.TestCheckResourceAttrSet(resName, "transaction_id"),
@@ -89,7 +101,7 @@ func TestAccOPCStorageObject_fileSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "container", fmt.Sprintf("acc-test-%d", rInt)),
 					resource.TestCheckResourceAttrSet(resName, "content_length"),
 					resource.TestCheckResourceAttr(resName, "content_type", "text/plain;charset=UTF-8"),
-					resource.TestCheckResourceAttr(resName, "delete_at", "0"),
+					resource.TestCheckResourceAttr(resName, "delete_at", ""),
 					resource.TestCheckResourceAttrSet(resName, "last_modified"),
 					resource.TestCheckResourceAttrSet(resName, "timestamp"),
 					resource.TestCheckResourceAttrSet(resName, "transaction_id"),
@@ -125,6 +137,599 @@ func TestAccOPCStorageObject_objectMetadata(t *testing.T) {
 	})
 }
 
+func TestStorageObjectRead_unquotedETag(t *testing.T) {
+	const etag = "5d41402abc4b2a76b9719d911017c592"
+
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", fmt.Sprintf("%q", etag))
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", "5")
+		w.Header().Set("X-Delete-At", "0")
+	})
+
+	raw := map[string]interface{}{
+		"name":         "test-object",
+		"container":    "test-container",
+		"content":      "hello",
+		"content_type": "text/plain",
+		"etag":         etag,
+	}
+	r := resourceOPCStorageObject()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("test-container/test-object")
+
+	state, err := r.Refresh(d.State(), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := state.Attributes["etag"]; v != etag {
+		t.Fatalf("Expected etag %q, got %q", etag, v)
+	}
+
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no diff for an unchanged object, got %#v", diff.Attributes)
+	}
+}
+
+func TestStorageObjectCreate_inlineContent(t *testing.T) {
+	cases := []struct {
+		name, content, contentType, expected string
+	}{
+		{"config.json", `{"debug": true}`, "", "application/json"},
+		{"motd", "hello", "", "text/plain; charset=utf-8"},
+		{"page", "<html><body></body></html>", "", "text/html; charset=utf-8"},
+		{"motd", "hello", "text/x-custom", "text/x-custom"},
+	}
+	for _, c := range cases {
+		var put *http.Request
+		meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				put = r
+				w.Header().Set("ETag", r.Header.Get("ETag"))
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.Header().Set("ETag", put.Header.Get("ETag"))
+			w.Header().Set("Content-Type", put.Header.Get("Content-Type"))
+			w.Header().Set("Content-Length", fmt.Sprint(len(c.content)))
+		})
+
+		raw := map[string]interface{}{
+			"name":      c.name,
+			"container": "test-container",
+			"content":   c.content,
+		}
+		if c.contentType != "" {
+			raw["content_type"] = c.contentType
+		}
+		state := testStorageObjectCreate(t, meta, raw)
+
+		checksum := md5.Sum([]byte(c.content))
+		if etag := hex.EncodeToString(checksum[:]); put.Header.Get("ETag") != etag || state.Attributes["etag"] != etag {
+			t.Fatalf("Expected %s to be uploaded and stored with etag %s, got %q and %q",
+				c.name, etag, put.Header.Get("ETag"), state.Attributes["etag"])
+		}
+		if v := state.Attributes["content_type"]; v != c.expected {
+			t.Fatalf("Expected %s to have content type %q, got %q", c.name, c.expected, v)
+		}
+	}
+}
+
+func TestStorageObject_metadataUpdatedInPlace(t *testing.T) {
+	var requests []string
+	var post http.Header
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		if r.Method == "POST" {
+			post = r.Header
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Disposition", "attachment")
+		w.Header().Set("X-Object-Meta-Owner", "ops")
+	})
+
+	r := resourceOPCStorageObject()
+	state := &terraform.InstanceState{
+		ID: "test-container/test-object",
+		Attributes: map[string]string{
+			"name":                "test-object",
+			"container":           "test-container",
+			"content":             "hello",
+			"content_disposition": "attachment",
+			"metadata.%":          "2",
+			"metadata.Owner":      "dev",
+			"metadata.Stage":      "test",
+		},
+	}
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":                "test-object",
+		"container":           "test-container",
+		"content":             "hello",
+		"content_disposition": "attachment",
+		"metadata":            map[string]interface{}{"Owner": "ops"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("Expected a metadata change to be made in place, got %#v", diff.Attributes)
+	}
+
+	updated, err := r.Apply(state, diff, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests[0] != "POST" {
+		t.Fatalf("Expected the metadata to be updated with a POST, got %v", requests)
+	}
+	if post.Get("X-Object-Meta-Owner") != "ops" || post.Get("X-Object-Meta-Stage") != "" {
+		t.Fatalf("Expected only the configured metadata to be sent, got %v", post)
+	}
+	if post.Get("Content-Disposition") != "attachment" {
+		t.Fatal("Expected the unchanged content disposition to be kept")
+	}
+	if updated.Attributes["metadata.%"] != "1" || updated.Attributes["metadata.Owner"] != "ops" {
+		t.Fatalf("Expected the metadata to be read back, got %v", updated.Attributes)
+	}
+}
+
+func TestStorageObjectImport(t *testing.T) {
+	file, err := ioutil.TempFile("", "opc-storage-object-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("hello")
+	file.Close()
+
+	var methods []string
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("ETag", `"5d41402abc4b2a76b9719d911017c592"`)
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", "5")
+		w.Header().Set("X-Object-Meta-Owner", "ci")
+	})
+
+	r := resourceOPCStorageObject()
+	d := r.Data(&terraform.InstanceState{ID: "test-container/path/to/object"})
+	imported, err := r.Importer.State(d, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 1 || methods[0] != "HEAD" {
+		t.Fatalf("Expected the object to be imported with a single HEAD, got %v", methods)
+	}
+	state := imported[0].State()
+	expected := map[string]string{
+		"container":      "test-container",
+		"name":           "path/to/object",
+		"content_type":   "text/plain",
+		"etag":           "5d41402abc4b2a76b9719d911017c592",
+		"metadata.Owner": "ci",
+		"source":         "5d41402abc4b2a76b9719d911017c592",
+	}
+	for k, v := range expected {
+		if state.Attributes[k] != v {
+			t.Fatalf("Expected imported %s to be %q, got %q", k, v, state.Attributes[k])
+		}
+	}
+
+	// A source file with the object's content adopts it without replacing it
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":         "path/to/object",
+		"container":    "test-container",
+		"source":       file.Name(),
+		"content_type": "text/plain",
+		"metadata":     map[string]interface{}{"Owner": "ci"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("Expected the imported object to be adopted, got %#v", diff.Attributes)
+	}
+
+	if _, err := r.Importer.State(r.Data(&terraform.InstanceState{ID: "no-object"}), meta); err == nil {
+		t.Fatal("Expected an ID without an object name to be rejected")
+	}
+}
+
+func TestStorageObject_expiry(t *testing.T) {
+	var requests []*http.Request
+	deleteAt := "1700000000"
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "POST":
+			deleteAt = r.Header.Get("X-Delete-At")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.Header().Set("X-Delete-At", deleteAt)
+		}
+	})
+
+	r := resourceOPCStorageObject()
+	raw := map[string]interface{}{
+		"name":         "test-object",
+		"container":    "test-container",
+		"content":      "hello",
+		"delete_after": "720h",
+	}
+	state := testStorageObjectCreate(t, meta, raw)
+	if v := requests[0].Header.Get("X-Delete-After"); v != "2592000" {
+		t.Fatalf("Expected the object to expire after 2592000 seconds, got %q", v)
+	}
+	if v := state.Attributes["delete_at"]; v != "2023-11-14T22:13:20Z" {
+		t.Fatalf("Expected the effective expiry to be read back, got %q", v)
+	}
+
+	// The same time in either format plans no change, and a new time is updated in place
+	delete(raw, "delete_after")
+	for _, c := range []struct {
+		deleteAt string
+		changed  bool
+	}{
+		{"2023-11-14T22:13:20Z", false},
+		{"1700000000", false},
+		{"2023-11-15T00:00:00+01:00", true},
+	} {
+		raw["delete_at"] = c.deleteAt
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if attr := diff.Attributes["delete_at"]; (attr != nil) != c.changed {
+			t.Fatalf("Expected delete_at %s to change: %t, got %#v", c.deleteAt, c.changed, attr)
+		}
+		if !c.changed {
+			continue
+		}
+		if diff.RequiresNew() {
+			t.Fatalf("Expected the expiry to be changed in place, got %#v", diff.Attributes)
+		}
+		requests = nil
+		updated, err := r.Apply(state, diff, meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if requests[0].Method != "POST" || requests[0].Header.Get("X-Delete-At") != "1700002800" {
+			t.Fatalf("Expected the expiry to be updated with a POST, got %s %v", requests[0].Method, requests[0].Header)
+		}
+		if v := updated.Attributes["delete_at"]; v != "2023-11-14T23:00:00Z" {
+			t.Fatalf("Expected the updated expiry to be read back, got %q", v)
+		}
+	}
+}
+
+func TestStorageObject_expiryValidation(t *testing.T) {
+	for _, c := range []map[string]interface{}{
+		{"delete_at": "2023-11-14T22:13:20Z", "delete_after": "1h"},
+		{"delete_at": "tomorrow"},
+		{"delete_after": "30"},
+	} {
+		c["name"] = "test-object"
+		c["container"] = "test-container"
+		c["content"] = "hello"
+		rawConfig, err := config.NewRawConfig(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, errs := resourceOPCStorageObject().Validate(terraform.NewResourceConfig(rawConfig)); len(errs) == 0 {
+			t.Fatalf("Expected %v to fail validation", c)
+		}
+	}
+}
+
+func TestStorageObject_timeouts(t *testing.T) {
+	file, err := ioutil.TempFile("", "opc-storage-object-timeouts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("hello")
+	file.Close()
+
+	// Uploads take longer than the provider's request timeout
+	requestTimeout := 50 * time.Millisecond
+	meta := testStorageMetaConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+		}
+	}, func(config *opc.Config) {
+		config.RequestTimeout = &requestTimeout
+	})
+
+	raw := map[string]interface{}{
+		"name":      "test-object",
+		"container": "test-container",
+		"source":    file.Name(),
+	}
+	testStorageObjectCreate(t, meta, raw)
+
+	// The create timeout still bounds the upload
+	raw["timeouts"] = []map[string]interface{}{{"create": "100ms"}}
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := resourceOPCStorageObject()
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Apply(nil, diff, meta); err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("Expected the upload to time out, got %v", err)
+	}
+
+	// Content is small, so its upload is bound by the request timeout
+	delete(raw, "source")
+	delete(raw, "timeouts")
+	raw["content"] = "hello"
+	rawConfig, err = config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err = r.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Apply(nil, diff, meta); err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("Expected the request to time out, got %v", err)
+	}
+}
+
+func TestStorageObject_contentDisposition(t *testing.T) {
+	disposition := ""
+	var methods []string
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case "PUT", "POST":
+			disposition = r.Header.Get("Content-Disposition")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.Header().Set("Content-Disposition", disposition)
+		}
+	})
+
+	raw := map[string]interface{}{
+		"name":                "report.csv",
+		"container":           "test-container",
+		"content":             "a,b",
+		"content_disposition": `attachment; filename="report.csv"`,
+	}
+	state := testStorageObjectCreate(t, meta, raw)
+	if disposition != `attachment; filename="report.csv"` || state.Attributes["content_disposition"] != disposition {
+		t.Fatalf("Expected the content disposition to be uploaded and read back, got %q", state.Attributes["content_disposition"])
+	}
+
+	raw["content_disposition"] = `attachment; filename="2024-report.csv"`
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := resourceOPCStorageObject()
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("Expected the content disposition to be changed in place, got %#v", diff.Attributes)
+	}
+	methods = nil
+	if state, err = r.Apply(state, diff, meta); err != nil {
+		t.Fatal(err)
+	}
+	if methods[0] != "POST" || state.Attributes["content_disposition"] != `attachment; filename="2024-report.csv"` {
+		t.Fatalf("Expected the content disposition to be updated with a POST, got %v and %q", methods, state.Attributes["content_disposition"])
+	}
+
+	raw["content_disposition"] = `attachment; filename=`
+	rawConfig, err = config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, errs := r.Validate(terraform.NewResourceConfig(rawConfig)); len(errs) == 0 {
+		t.Fatal("Expected a malformed content disposition to fail validation")
+	}
+}
+
+func TestStorageObject_gzip(t *testing.T) {
+	var body []byte
+	var headers http.Header
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			body, _ = ioutil.ReadAll(r.Body)
+			headers = r.Header
+			w.Header().Set("ETag", headers.Get("ETag"))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.Header().Set("ETag", headers.Get("ETag"))
+			w.Header().Set("Content-Encoding", headers.Get("Content-Encoding"))
+		}
+	})
+
+	content := strings.Repeat("body { color: red; }\n", 100)
+	state := testStorageObjectCreate(t, meta, map[string]interface{}{
+		"name":      "style.css",
+		"container": "test-container",
+		"content":   content,
+		"gzip":      true,
+	})
+
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(uncompressed) != content || len(body) >= len(content) {
+		t.Fatalf("Expected the content to be uploaded compressed, got %d bytes", len(body))
+	}
+	checksum := md5.Sum(body)
+	if etag := hex.EncodeToString(checksum[:]); headers.Get("ETag") != etag || state.Attributes["etag"] != etag {
+		t.Fatalf("Expected the etag of the compressed content %s, got %q and %q", etag, headers.Get("ETag"), state.Attributes["etag"])
+	}
+	if headers.Get("Content-Type") != "text/css; charset=utf-8" || state.Attributes["content_encoding"] != "gzip" {
+		t.Fatalf("Expected the type of the uncompressed content and gzip encoding, got %v", headers)
+	}
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":             "style.css",
+		"container":        "test-container",
+		"content":          content,
+		"gzip":             true,
+		"content_encoding": "br",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := resourceOPCStorageObject()
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Apply(nil, diff, meta); err == nil || !strings.Contains(err.Error(), "must be gzip") {
+		t.Fatalf("Expected another content encoding to be refused, got %v", err)
+	}
+}
+
+func TestStorageObject_contentConflictsWithSource(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":      "test-object",
+		"container": "test-container",
+		"content":   "hello",
+		"source":    "hello.txt",
+	}
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, errs := resourceOPCStorageObject().Validate(terraform.NewResourceConfig(rawConfig))
+	if len(errs) == 0 {
+		t.Fatal("Expected setting both content and source to fail validation")
+	}
+}
+
+func TestStorageObject_sourceChangeRequiresReplacement(t *testing.T) {
+	file, err := ioutil.TempFile("", "opc-storage-object-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("hello")
+	file.Close()
+
+	r := resourceOPCStorageObject()
+	raw := map[string]interface{}{
+		"name":      "test-object",
+		"container": "test-container",
+		"source":    file.Name(),
+	}
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := &terraform.InstanceState{
+		ID: "test-container/test-object",
+		Attributes: map[string]string{
+			"name":      "test-object",
+			"container": "test-container",
+			"source":    storageObjectSourceState(file.Name()),
+		},
+	}
+	if v := state.Attributes["source"]; v != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("Expected the source to be stored as the MD5 of the file, got %q", v)
+	}
+
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["source"]; attr != nil && attr.Old != attr.New {
+		t.Fatalf("Expected no diff of the source for an unchanged file, got %#v", attr)
+	}
+
+	if err := ioutil.WriteFile(file.Name(), []byte("goodbye"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = r.Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["source"]; attr == nil || !attr.RequiresNew {
+		t.Fatalf("Expected a changed file to replace the object, got %#v", attr)
+	}
+}
+
+func TestStorageObject_deleteSegments(t *testing.T) {
+	var deletes []string
+	meta := testStorageMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "HEAD":
+			w.Header().Set("X-Static-Large-Object", "True")
+		case "DELETE":
+			deletes = append(deletes, r.URL.RawQuery)
+			if r.URL.Query().Get("multipart-manifest") == "delete" {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"Number Deleted": 3, "Response Status": "200 OK", "Errors": []}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	// Only an object that isn't a dynamic large object is deleted with its segments
+	for manifest, query := range map[string]string{
+		"":                           "multipart-manifest=delete",
+		"test-container_segments/a/": "",
+	} {
+		deletes = nil
+		state := &terraform.InstanceState{
+			ID: "test-container/backup.tar",
+			Attributes: map[string]string{
+				"name":            "backup.tar",
+				"container":       "test-container",
+				"object_manifest": manifest,
+			},
+		}
+		if _, err := resourceOPCStorageObject().Apply(state, &terraform.InstanceDiff{Destroy: true}, meta); err != nil {
+			t.Fatal(err)
+		}
+		if len(deletes) != 1 || deletes[0] != query {
+			t.Fatalf("Expected a single DELETE with query %q for manifest %q, got %q", query, manifest, deletes)
+		}
+	}
+}
+
 func testAccCheckStorageObjectExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*OPCClient).storageClient.Objects()
 
@@ -263,3 +868,22 @@ eleifend id, tincidunt eget dolor. Nam pulvinar, dui non pellentesque dignissim,
 nisi nunc vel turpis. Vivamus eget dapibus lacus. Mauris convallis mi sit amet faucibus placerat. Mauris gravida neque
 tortor, vel placerat sem elementum venenatis. Integer eu placerat est. Sed sem massa, volutpat eget augue eget, aliquam
 semper sem.`
+
+// Creates the object from the configuration as Terraform would, so the resource's
+// timeouts are set, returning its state
+func testStorageObjectCreate(t *testing.T, meta *OPCClient, raw map[string]interface{}) *terraform.InstanceState {
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := resourceOPCStorageObject()
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := r.Apply(nil, diff, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}